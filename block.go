@@ -0,0 +1,278 @@
+package color
+
+import (
+	"regexp"
+	"strings"
+)
+
+/*************************************************************
+ * Theme.Render: border, padding and alignment for boxed notices
+ *************************************************************/
+
+// Align is the horizontal alignment used by Theme.Render.
+type Align uint8
+
+// alignment modes for Theme.Render
+const (
+	AlignLeft Align = iota
+	AlignCenter
+	AlignRight
+)
+
+// Border describes the runes used to draw a box around a themed block.
+type Border struct {
+	Top, Right, Bottom, Left rune
+
+	TopLeft, TopRight, BottomRight, BottomLeft rune
+}
+
+// NormalBorder is a plain single-line box border.
+var NormalBorder = &Border{
+	Top: '─', Bottom: '─', Left: '│', Right: '│',
+	TopLeft: '┌', TopRight: '┐', BottomLeft: '└', BottomRight: '┘',
+}
+
+// RoundedBorder is a single-line box border with rounded corners.
+var RoundedBorder = &Border{
+	Top: '─', Bottom: '─', Left: '│', Right: '│',
+	TopLeft: '╭', TopRight: '╮', BottomLeft: '╰', BottomRight: '╯',
+}
+
+// PanelError, PanelInfo and PanelSuccess are ready to use boxed-notice
+// themes for use with Theme.Block / Theme.Render.
+//
+// Usage:
+//	color.PanelError.Block("request failed: %s", err)
+var (
+	PanelError = &Theme{
+		Name: "panel-error", Style: Style{FgLightWhite, BgRed},
+		Border: RoundedBorder, Padding: [4]int{0, 1, 0, 1},
+	}
+	PanelInfo = &Theme{
+		Name: "panel-info", Style: Style{FgLightWhite, BgBlue},
+		Border: RoundedBorder, Padding: [4]int{0, 1, 0, 1},
+	}
+	PanelSuccess = &Theme{
+		Name: "panel-success", Style: Style{FgLightWhite, BgGreen},
+		Border: RoundedBorder, Padding: [4]int{0, 1, 0, 1},
+	}
+)
+
+// ansiRe matches ANSI escape sequences, used to measure visible width
+// without counting the (invisible) color codes.
+var ansiRe = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// visibleWidth returns the rune width of s ignoring ANSI escape sequences.
+// Wide (e.g. CJK) runes are not accounted for; callers needing accurate
+// terminal columns for those should pre-measure with a rune-width table.
+func visibleWidth(s string) int {
+	return len([]rune(ansiRe.ReplaceAllString(s, "")))
+}
+
+// ansiTokens splits s into a sequence of tokens, each either one visible
+// rune or one complete ANSI escape sequence, in order. Wrapping on these
+// tokens (rather than raw runes) keeps an escape sequence that an already
+// styled %s argument embeds (e.g. someStyle.Render(err) passed into a
+// Block/Message format string) from ever being split mid-sequence.
+func ansiTokens(s string) []string {
+	var tokens []string
+
+	last := 0
+	for _, m := range ansiRe.FindAllStringIndex(s, -1) {
+		for _, r := range s[last:m[0]] {
+			tokens = append(tokens, string(r))
+		}
+		tokens = append(tokens, s[m[0]:m[1]])
+		last = m[1]
+	}
+	for _, r := range s[last:] {
+		tokens = append(tokens, string(r))
+	}
+
+	return tokens
+}
+
+// wrapLine hard-wraps line to width columns, counting only visible runes
+// so embedded ANSI escape sequences are carried whole onto whichever
+// wrapped segment they fall in, instead of being split apart.
+func wrapLine(line string, width int) []string {
+	if width <= 0 || visibleWidth(line) <= width {
+		return []string{line}
+	}
+
+	var (
+		lines []string
+		b     strings.Builder
+		col   int
+	)
+	for _, tok := range ansiTokens(line) {
+		if strings.HasPrefix(tok, "\x1b") {
+			b.WriteString(tok)
+			continue
+		}
+
+		if col == width {
+			lines = append(lines, b.String())
+			b.Reset()
+			col = 0
+		}
+		b.WriteString(tok)
+		col++
+	}
+	lines = append(lines, b.String())
+
+	return lines
+}
+
+// wrapText hard-wraps text to width columns, respecting existing newlines.
+func wrapText(text string, width int) []string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		lines = append(lines, wrapLine(line, width)...)
+	}
+	return lines
+}
+
+// render is a rendering function applied to filler spans (padding,
+// margin, border runes); it is the identity function when color is
+// disabled for the current Renderer, and the theme's Style.Render
+// otherwise, so a single func value gates every piece of block output.
+type render func(string) string
+
+// alignLine pads line to width columns according to align, using spaces
+// passed through render so a colored background extends across the block.
+func alignLine(line string, width int, align Align, r render) string {
+	pad := width - visibleWidth(line)
+	if pad < 0 {
+		pad = 0
+	}
+
+	var left, right int
+	switch align {
+	case AlignCenter:
+		left = pad / 2
+		right = pad - left
+	case AlignRight:
+		left = pad
+	default: // AlignLeft
+		right = pad
+	}
+
+	return r(strings.Repeat(" ", left)) + line + r(strings.Repeat(" ", right))
+}
+
+// Render hard-wraps text to t.Width (capped by t.MaxWidth), aligns and
+// styles each line, applies t.Padding, draws t.Border, and surrounds the
+// result with t.Margin. Styling is gated on DefaultRenderer's detected
+// capability; use RenderWith to gate it on another Renderer.
+func (t *Theme) Render(text string) string {
+	return t.RenderWith(DefaultRenderer(), text)
+}
+
+// RenderWith is Render, but skips all ANSI styling when r reports color is
+// not enabled for its bound writer (e.g. r is writing to a log file). A nil
+// r always styles.
+func (t *Theme) RenderWith(r *Renderer, text string) string {
+	style := func(s string) string { return s }
+	if r == nil || r.isEnabled() {
+		style = func(s string) string { return t.Style.Render(s) }
+	}
+
+	width := t.Width
+	if t.MaxWidth > 0 && (width == 0 || width > t.MaxWidth) {
+		width = t.MaxWidth
+	}
+
+	lines := wrapText(text, width)
+
+	// determine the content width: widest line, or the fixed width
+	contentWidth := width
+	if contentWidth == 0 {
+		for _, line := range lines {
+			if w := visibleWidth(line); w > contentWidth {
+				contentWidth = w
+			}
+		}
+	}
+
+	styled := make([]string, 0, len(lines))
+	for _, line := range lines {
+		styled = append(styled, alignLine(style(line), contentWidth, t.Align, style))
+	}
+
+	styled = applyPadding(styled, t.Padding, contentWidth, style)
+
+	if t.Border != nil {
+		styled = applyBorder(styled, t.Border, contentWidth+t.Padding[1]+t.Padding[3], style)
+	}
+
+	boxWidth := contentWidth + t.Padding[1] + t.Padding[3]
+	if t.Border != nil {
+		boxWidth += 2
+	}
+	styled = applyMargin(styled, t.Margin, boxWidth)
+
+	return strings.Join(styled, "\n") + "\n"
+}
+
+// applyPadding prepends/appends blank styled lines and left/right spacing
+// per Theme.Padding, which is [top, right, bottom, left].
+func applyPadding(lines []string, padding [4]int, width int, r render) []string {
+	top, right, bottom, left := padding[0], padding[1], padding[2], padding[3]
+
+	blank := r(strings.Repeat(" ", width+left+right))
+
+	out := make([]string, 0, len(lines)+top+bottom)
+	for i := 0; i < top; i++ {
+		out = append(out, blank)
+	}
+	for _, line := range lines {
+		out = append(out, r(strings.Repeat(" ", left))+line+r(strings.Repeat(" ", right)))
+	}
+	for i := 0; i < bottom; i++ {
+		out = append(out, blank)
+	}
+	return out
+}
+
+// applyBorder draws b around lines, each assumed to be width columns wide,
+// styling the border runes via r.
+func applyBorder(lines []string, b *Border, width int, r render) []string {
+	h := strings.Repeat(string(b.Top), width)
+	top := r(string(b.TopLeft) + h + string(b.TopRight))
+
+	hb := strings.Repeat(string(b.Bottom), width)
+	bottom := r(string(b.BottomLeft) + hb + string(b.BottomRight))
+
+	out := make([]string, 0, len(lines)+2)
+	out = append(out, top)
+	for _, line := range lines {
+		out = append(out, r(string(b.Left))+line+r(string(b.Right)))
+	}
+	out = append(out, bottom)
+	return out
+}
+
+// applyMargin surrounds lines (each width columns wide) with blank, always
+// unstyled lines/spacing per Theme.Margin ([top, right, bottom, left]),
+// since a margin sits outside the box and shouldn't carry its background.
+func applyMargin(lines []string, margin [4]int, width int) []string {
+	top, right, bottom, left := margin[0], margin[1], margin[2], margin[3]
+	if top == 0 && right == 0 && bottom == 0 && left == 0 {
+		return lines
+	}
+
+	blank := strings.Repeat(" ", width+left+right)
+
+	out := make([]string, 0, len(lines)+top+bottom)
+	for i := 0; i < top; i++ {
+		out = append(out, blank)
+	}
+	for _, line := range lines {
+		out = append(out, strings.Repeat(" ", left)+line+strings.Repeat(" ", right))
+	}
+	for i := 0; i < bottom; i++ {
+		out = append(out, blank)
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package color
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTheme_RenderWith_disabledSkipsANSI(t *testing.T) {
+	buf := new(bytes.Buffer)
+	r := NewRendererWithMode(buf, ModeNever)
+
+	theme := &Theme{Name: "test", Style: Style{FgLightWhite, BgRed}, Border: RoundedBorder, Padding: [4]int{0, 1, 0, 1}}
+	out := theme.RenderWith(r, "hello")
+
+	if strings.Contains(out, "\x1b[") {
+		t.Fatalf("RenderWith with ModeNever renderer must not emit ANSI, got %q", out)
+	}
+	if !strings.Contains(out, "hello") {
+		t.Fatalf("expected rendered output to contain the source text, got %q", out)
+	}
+}
+
+func TestWrapLine_preservesEmbeddedANSISequences(t *testing.T) {
+	line := "plain \x1b[31mRED\x1b[0m tail"
+
+	lines := wrapLine(line, 10)
+
+	for _, l := range lines {
+		if n := strings.Count(l, "\x1b["); n > 0 {
+			// every escape sequence present must be complete (end with 'm')
+			for _, seq := range ansiRe.FindAllString(l, -1) {
+				if !strings.HasSuffix(seq, "m") {
+					t.Fatalf("corrupted ANSI sequence in wrapped line %q: %q", l, seq)
+				}
+			}
+		}
+	}
+
+	// rejoining must reproduce a string whose escape sequences are untouched
+	joined := strings.Join(lines, "")
+	if got, want := ansiRe.FindAllString(joined, -1), ansiRe.FindAllString(line, -1); strings.Join(got, "") != strings.Join(want, "") {
+		t.Fatalf("wrapping altered the escape sequences: got %v, want %v", got, want)
+	}
+}
+
+func TestAlignLine_centersWithinWidth(t *testing.T) {
+	got := alignLine("hi", 6, AlignCenter, func(s string) string { return s })
+	if visibleWidth(got) != 6 {
+		t.Fatalf("expected width 6, got %d (%q)", visibleWidth(got), got)
+	}
+	if got != "  hi  " {
+		t.Fatalf("expected evenly centered padding, got %q", got)
+	}
+}
+
+func TestTheme_Render_appliesMargin(t *testing.T) {
+	theme := &Theme{Name: "test", Style: Style{}, Margin: [4]int{1, 2, 1, 2}}
+
+	out := theme.RenderWith(NewRendererWithMode(new(bytes.Buffer), ModeNever), "hi")
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+
+	if len(lines) != 3 {
+		t.Fatalf("expected 1 content line + 2 margin lines, got %d: %v", len(lines), lines)
+	}
+	if strings.TrimSpace(lines[0]) != "" || strings.TrimSpace(lines[2]) != "" {
+		t.Fatalf("expected blank top/bottom margin lines, got %v", lines)
+	}
+	if !strings.HasPrefix(lines[1], "  ") || !strings.HasSuffix(lines[1], "  ") {
+		t.Fatalf("expected left/right margin spacing around content, got %q", lines[1])
+	}
+}
@@ -0,0 +1,286 @@
+package color
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*************************************************************
+ * Logger: leveled logging on top of the Theme system
+ *************************************************************/
+
+// Level is a logger severity level.
+type Level uint8
+
+// logger levels, from least to most severe
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelNotice
+	LevelWarn
+	LevelError
+	LevelCritical
+)
+
+// levelThemes maps a Level to the Theme used to render its tag and, by
+// default, its message body. Custom themes added via AddTheme can be
+// wired in with Logger.SetLevelTheme so user-defined levels reuse the
+// same registry CLIs already configure for Info/Warn/Error.
+var levelThemes = map[Level]*Theme{
+	LevelDebug:    Debug,
+	LevelInfo:     Info,
+	LevelNotice:   Notice,
+	LevelWarn:     Warn,
+	LevelError:    Error,
+	LevelCritical: Danger,
+}
+
+// levelNames is the upper-case tag rendered for each Level.
+var levelNames = map[Level]string{
+	LevelDebug:    "DEBUG",
+	LevelInfo:     "INFO",
+	LevelNotice:   "NOTICE",
+	LevelWarn:     "WARN",
+	LevelError:    "ERROR",
+	LevelCritical: "CRITICAL",
+}
+
+// Logger is a small leveled logger built on the existing Theme
+// registry: each level is styled by its matching Theme, so a custom
+// theme added via AddTheme can double as a log level by pairing it
+// with SetLevelTheme. It is safe for concurrent use.
+//
+// Usage:
+//	l := color.NewLogger(os.Stderr, color.LevelInfo)
+//	l.Info("server started")
+//	l.Errorf("failed to connect: %s", err)
+type Logger struct {
+	mu sync.Mutex
+
+	r *Renderer
+	// followOutput makes log() rebind r to color.Output whenever it
+	// changes, instead of staying frozen on whatever Output was at
+	// construction time. Only DefaultLogger sets this; a Logger built via
+	// NewLogger(w, ...) was bound to an explicit writer and should stay there.
+	followOutput bool
+	lastOutput   io.Writer
+
+	minLevel   Level
+	themes     map[Level]*Theme
+	withTime   bool
+	withCaller bool
+}
+
+// NewLogger create a logger that writes to w, rendering via a Renderer
+// bound to w, and only emitting messages at minLevel or more severe.
+func NewLogger(w io.Writer, minLevel Level) *Logger {
+	return &Logger{
+		r:        NewRenderer(w),
+		minLevel: minLevel,
+		themes:   levelThemes,
+	}
+}
+
+// DefaultLogger is a ready to use Logger writing to Output at LevelInfo.
+// Unless SetOutput is called on it, it follows color.Output if that is
+// reassigned later, the same way DefaultRenderer does.
+var DefaultLogger = newDefaultLogger()
+
+func newDefaultLogger() *Logger {
+	l := NewLogger(Output, LevelInfo)
+	l.followOutput = true
+	l.lastOutput = Output
+	return l
+}
+
+// SetOutput changes the writer the logger writes to. A fresh Renderer is
+// bound to w so color is re-detected (a file writer disables color, a
+// TTY keeps it). This also stops the logger from auto-following
+// color.Output, since the caller has now bound it explicitly.
+func (l *Logger) SetOutput(w io.Writer) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.r = NewRenderer(w)
+	l.followOutput = false
+}
+
+// SetMinLevel changes the minimum level that will be logged.
+func (l *Logger) SetMinLevel(level Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.minLevel = level
+}
+
+// WithTimestamp enables/disables a "2006-01-02 15:04:05" prefix on each line.
+func (l *Logger) WithTimestamp(enable bool) *Logger {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.withTime = enable
+	return l
+}
+
+// WithCaller enables/disables a "file:line" prefix on each line.
+func (l *Logger) WithCaller(enable bool) *Logger {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.withCaller = enable
+	return l
+}
+
+// SetLevelTheme overrides the Theme used to render level, e.g. to point a
+// custom level at a theme registered with AddTheme.
+func (l *Logger) SetLevelTheme(level Level, theme *Theme) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// copy-on-write so the shared levelThemes default map is untouched
+	themes := make(map[Level]*Theme, len(l.themes)+1)
+	for lv, th := range l.themes {
+		themes[lv] = th
+	}
+	themes[level] = theme
+	l.themes = themes
+}
+
+// log renders and writes one line at level.
+func (l *Logger) log(level Level, msg string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if level < l.minLevel {
+		return
+	}
+
+	if l.followOutput && l.lastOutput != Output {
+		l.r = NewRenderer(Output)
+		l.lastOutput = Output
+	}
+
+	theme := l.themes[level]
+	if theme == nil {
+		theme = Info
+	}
+
+	var b strings.Builder
+	if l.withTime {
+		b.WriteString(time.Now().Format("2006-01-02 15:04:05"))
+		b.WriteByte(' ')
+	}
+	if l.withCaller {
+		b.WriteString(caller())
+		b.WriteByte(' ')
+	}
+
+	b.WriteString(l.r.Render(theme.Style, levelNames[level]+":"))
+	b.WriteByte(' ')
+	b.WriteString(msg)
+
+	l.r.Println(New(), b.String())
+}
+
+// pkgPath is this package's import path, used by caller to recognize and
+// skip its own frames (Logger methods and the package-level Log* shortcuts)
+// regardless of how many wrapper calls sit between it and the real caller.
+var pkgPath = reflect.TypeOf(Logger{}).PkgPath()
+
+// caller returns "file:line" for the first stack frame outside this
+// package, so it reports the real call site whether log() was reached
+// directly through a Logger method or via a package-level Log* shortcut.
+func caller() string {
+	for skip := 2; skip < 32; skip++ {
+		pc, file, line, ok := runtime.Caller(skip)
+		if !ok {
+			break
+		}
+
+		if fn := runtime.FuncForPC(pc); fn != nil && strings.HasPrefix(fn.Name(), pkgPath+".") {
+			continue
+		}
+
+		if i := strings.LastIndexByte(file, '/'); i >= 0 {
+			file = file[i+1:]
+		}
+		return file + ":" + strconv.Itoa(line)
+	}
+	return "???"
+}
+
+// Critical logs a message at LevelCritical.
+func (l *Logger) Critical(a ...interface{}) { l.log(LevelCritical, fmt.Sprint(a...)) }
+
+// Criticalf logs a formatted message at LevelCritical.
+func (l *Logger) Criticalf(format string, a ...interface{}) {
+	l.log(LevelCritical, fmt.Sprintf(format, a...))
+}
+
+// Error logs a message at LevelError.
+func (l *Logger) Error(a ...interface{}) { l.log(LevelError, fmt.Sprint(a...)) }
+
+// Errorf logs a formatted message at LevelError.
+func (l *Logger) Errorf(format string, a ...interface{}) {
+	l.log(LevelError, fmt.Sprintf(format, a...))
+}
+
+// Warn logs a message at LevelWarn.
+func (l *Logger) Warn(a ...interface{}) { l.log(LevelWarn, fmt.Sprint(a...)) }
+
+// Warnf logs a formatted message at LevelWarn.
+func (l *Logger) Warnf(format string, a ...interface{}) {
+	l.log(LevelWarn, fmt.Sprintf(format, a...))
+}
+
+// Info logs a message at LevelInfo.
+func (l *Logger) Info(a ...interface{}) { l.log(LevelInfo, fmt.Sprint(a...)) }
+
+// Infof logs a formatted message at LevelInfo.
+func (l *Logger) Infof(format string, a ...interface{}) {
+	l.log(LevelInfo, fmt.Sprintf(format, a...))
+}
+
+// Debug logs a message at LevelDebug.
+func (l *Logger) Debug(a ...interface{}) { l.log(LevelDebug, fmt.Sprint(a...)) }
+
+// Debugf logs a formatted message at LevelDebug.
+func (l *Logger) Debugf(format string, a ...interface{}) {
+	l.log(LevelDebug, fmt.Sprintf(format, a...))
+}
+
+/*************************************************************
+ * package-level shortcuts, via DefaultLogger
+ *************************************************************/
+
+// LogCritical logs a message at LevelCritical on the DefaultLogger.
+func LogCritical(a ...interface{}) { DefaultLogger.Critical(a...) }
+
+// LogCriticalf logs a formatted message at LevelCritical on the DefaultLogger.
+func LogCriticalf(format string, a ...interface{}) { DefaultLogger.Criticalf(format, a...) }
+
+// LogError logs a message at LevelError on the DefaultLogger.
+func LogError(a ...interface{}) { DefaultLogger.Error(a...) }
+
+// LogErrorf logs a formatted message at LevelError on the DefaultLogger.
+func LogErrorf(format string, a ...interface{}) { DefaultLogger.Errorf(format, a...) }
+
+// LogWarn logs a message at LevelWarn on the DefaultLogger.
+func LogWarn(a ...interface{}) { DefaultLogger.Warn(a...) }
+
+// LogWarnf logs a formatted message at LevelWarn on the DefaultLogger.
+func LogWarnf(format string, a ...interface{}) { DefaultLogger.Warnf(format, a...) }
+
+// LogInfo logs a message at LevelInfo on the DefaultLogger.
+func LogInfo(a ...interface{}) { DefaultLogger.Info(a...) }
+
+// LogInfof logs a formatted message at LevelInfo on the DefaultLogger.
+func LogInfof(format string, a ...interface{}) { DefaultLogger.Infof(format, a...) }
+
+// LogDebug logs a message at LevelDebug on the DefaultLogger.
+func LogDebug(a ...interface{}) { DefaultLogger.Debug(a...) }
+
+// LogDebugf logs a formatted message at LevelDebug on the DefaultLogger.
+func LogDebugf(format string, a ...interface{}) { DefaultLogger.Debugf(format, a...) }
@@ -0,0 +1,235 @@
+package color
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+/*************************************************************
+ * Renderer: per-writer color rendering
+ *************************************************************/
+
+// ColorProfile the color rendering capability detected for a writer.
+type ColorProfile uint8
+
+// color profiles supported by a Renderer
+const (
+	ProfileNoColor ColorProfile = iota
+	Profile16
+	Profile256
+	ProfileTrueColor
+)
+
+// ColorMode controls how a Renderer decides whether to output color
+type ColorMode uint8
+
+// color modes for a Renderer
+const (
+	// ModeAuto detect color support from the writer and environment
+	ModeAuto ColorMode = iota
+	// ModeAlways always output color, ignoring detection
+	ModeAlways
+	// ModeNever never output color, always render raw text
+	ModeNever
+)
+
+// Renderer renders styled text for a specific io.Writer. It probes the
+// writer for color capability once and caches the result, so unlike the
+// package-level Print* funcs it does not depend on the global Output/
+// NoColor state. This makes it safe to bind several writers (a TTY, a
+// log file, an SSH session) at the same time, each with its own detected
+// profile.
+//
+// Usage:
+//	r := color.NewRenderer(os.Stdout)
+//	r.Print(color.FgGreen.Style(), "message")
+//
+//	buf := new(bytes.Buffer)
+//	r := color.NewRendererWithMode(buf, color.ModeNever)
+//	r.Print(color.FgGreen.Style(), "message") // no ANSI codes written
+type Renderer struct {
+	out  io.Writer
+	mode ColorMode
+
+	once    sync.Once
+	profile ColorProfile
+}
+
+// NewRenderer create a renderer bound to w, using ModeAuto detection.
+func NewRenderer(w io.Writer) *Renderer {
+	return &Renderer{out: w, mode: ModeAuto}
+}
+
+// NewRendererWithMode create a renderer bound to w with an explicit ColorMode.
+func NewRendererWithMode(w io.Writer, mode ColorMode) *Renderer {
+	return &Renderer{out: w, mode: mode}
+}
+
+var (
+	defaultRendererMu  sync.Mutex
+	defaultRenderer    *Renderer
+	defaultRendererOut io.Writer
+	defaultRendererSet bool
+)
+
+// DefaultRenderer gets the package-level default renderer used by Infof,
+// Errorln and friends. Unless overridden via SetDefaultRenderer, it is
+// lazily (re)bound to color.Output, so reassigning color.Output is picked
+// up on the next call rather than being frozen at package-init time.
+func DefaultRenderer() *Renderer {
+	defaultRendererMu.Lock()
+	defer defaultRendererMu.Unlock()
+
+	if !defaultRendererSet && (defaultRenderer == nil || defaultRendererOut != Output) {
+		defaultRenderer = NewRenderer(Output)
+		defaultRendererOut = Output
+	}
+	return defaultRenderer
+}
+
+// SetDefaultRenderer overrides the package-level default renderer, e.g. to
+// bind Infof/Errorln/etc. to a bytes.Buffer in tests or an SSH session
+// writer in a server, without needing to touch color.Output. The override
+// sticks until SetDefaultRenderer is called again.
+func SetDefaultRenderer(r *Renderer) {
+	defaultRendererMu.Lock()
+	defer defaultRendererMu.Unlock()
+
+	defaultRenderer = r
+	defaultRendererSet = true
+}
+
+// Profile detect(once) and return the color profile for the renderer's writer.
+func (r *Renderer) Profile() ColorProfile {
+	r.once.Do(func() {
+		r.profile = detectProfile(r.out)
+	})
+	return r.profile
+}
+
+// Writer returns the renderer's bound io.Writer.
+func (r *Renderer) Writer() io.Writer {
+	return r.out
+}
+
+// SetMode change the color mode of the renderer.
+func (r *Renderer) SetMode(mode ColorMode) {
+	r.mode = mode
+}
+
+// isEnabled reports whether this renderer should emit ANSI codes.
+func (r *Renderer) isEnabled() bool {
+	switch r.mode {
+	case ModeAlways:
+		return true
+	case ModeNever:
+		return false
+	default:
+		return r.Profile() != ProfileNoColor
+	}
+}
+
+// Render renders a with the given style, returning raw text when color is disabled.
+func (r *Renderer) Render(s Style, a ...interface{}) string {
+	if !r.isEnabled() {
+		return fmt.Sprint(a...)
+	}
+	return s.Render(a...)
+}
+
+// Sprint is alias of Render
+func (r *Renderer) Sprint(s Style, a ...interface{}) string {
+	return r.Render(s, a...)
+}
+
+// Sprintf formats and renders a message with the given style.
+func (r *Renderer) Sprintf(s Style, format string, a ...interface{}) string {
+	if !r.isEnabled() {
+		return fmt.Sprintf(format, a...)
+	}
+	return s.Sprintf(format, a...)
+}
+
+// Print renders and writes text to the renderer's writer.
+func (r *Renderer) Print(s Style, a ...interface{}) {
+	fmt.Fprint(r.out, r.Render(s, a...))
+}
+
+// Printf renders and writes formatted text to the renderer's writer.
+func (r *Renderer) Printf(s Style, format string, a ...interface{}) {
+	fmt.Fprint(r.out, r.Sprintf(s, format, a...))
+}
+
+// Println renders and writes a line of text to the renderer's writer.
+func (r *Renderer) Println(s Style, a ...interface{}) {
+	if !r.isEnabled() {
+		fmt.Fprintln(r.out, a...)
+		return
+	}
+	fmt.Fprintln(r.out, s.Render(a...))
+}
+
+// RenderWith renders a with s through r, returning raw text when color is disabled.
+// Usage:
+//	color.New(color.FgGreen).RenderWith(r, "text")
+func (s Style) RenderWith(r *Renderer, a ...interface{}) string {
+	return r.Render(s, a...)
+}
+
+// PrintWith renders and writes text with s through r.
+func (s Style) PrintWith(r *Renderer, a ...interface{}) {
+	r.Print(s, a...)
+}
+
+// PrintfWith renders and writes formatted text with s through r.
+func (s Style) PrintfWith(r *Renderer, format string, a ...interface{}) {
+	r.Printf(s, format, a...)
+}
+
+// PrintlnWith renders and writes a line of text with s through r.
+func (s Style) PrintlnWith(r *Renderer, a ...interface{}) {
+	r.Println(s, a...)
+}
+
+/*************************************************************
+ * capability detection
+ *************************************************************/
+
+// detect the color profile for w by probing for a TTY and reading
+// NO_COLOR/CLICOLOR_FORCE/TERM/COLORTERM, same rules most modern
+// terminal-aware CLIs (git, ls, ripgrep) already follow.
+func detectProfile(w io.Writer) ColorProfile {
+	if os.Getenv("NO_COLOR") != "" {
+		return ProfileNoColor
+	}
+
+	forced := os.Getenv("CLICOLOR_FORCE") != "" && os.Getenv("CLICOLOR_FORCE") != "0"
+	if !isTerminal(w) && !forced {
+		return ProfileNoColor
+	}
+
+	term := os.Getenv("TERM")
+	if term == "dumb" {
+		return ProfileNoColor
+	}
+
+	switch {
+	case isTrueColorTerm():
+		return ProfileTrueColor
+	case strings.Contains(term, "256color"):
+		return Profile256
+	case term == "" && !forced:
+		return ProfileNoColor
+	default:
+		return Profile16
+	}
+}
+
+// isTrueColorTerm reports whether COLORTERM advertises 24-bit support.
+func isTrueColorTerm() bool {
+	ct := os.Getenv("COLORTERM")
+	return ct == "truecolor" || ct == "24bit"
+}
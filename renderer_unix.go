@@ -0,0 +1,33 @@
+//go:build !windows
+// +build !windows
+
+package color
+
+import (
+	"io"
+	"os"
+	"runtime"
+	"syscall"
+	"unsafe"
+)
+
+// ioctl request number for fetching terminal attributes, which differs
+// between Linux and the BSD family (including darwin).
+func termiosIoctl() uintptr {
+	if runtime.GOOS == "linux" {
+		return 0x5401 // TCGETS
+	}
+	return 0x40487413 // TIOCGETA
+}
+
+// isTerminal reports whether w is connected to a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	var termios [256]byte
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), termiosIoctl(), uintptr(unsafe.Pointer(&termios[0])))
+	return errno == 0
+}
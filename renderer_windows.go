@@ -0,0 +1,26 @@
+//go:build windows
+// +build windows
+
+package color
+
+import (
+	"io"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+var kernel32 = syscall.NewLazyDLL("kernel32.dll")
+var procGetConsoleMode = kernel32.NewProc("GetConsoleMode")
+
+// isTerminal reports whether w is connected to a console.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	var mode uint32
+	ret, _, _ := procGetConsoleMode.Call(f.Fd(), uintptr(unsafe.Pointer(&mode)))
+	return ret != 0
+}
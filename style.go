@@ -91,17 +91,39 @@ func (s Style) IsEmpty() bool {
  * Theme(extended Style)
  *************************************************************/
 
-// Theme definition. extends from Style
+// Theme definition. extends from Style with optional layout properties
+// (border, padding, margin, alignment, width) used by Theme.Render to
+// draw boxed notices.
 type Theme struct {
 	// Name theme name
 	Name string
 	// Style for the theme
 	Style
+
+	// Border to draw around rendered blocks. nil means no border.
+	Border *Border
+	// Padding inside the border, as [top, right, bottom, left].
+	Padding [4]int
+	// Margin outside the border, as [top, right, bottom, left].
+	Margin [4]int
+	// Align horizontal alignment used by Render.
+	Align Align
+	// Width hard-wraps text to this many columns. 0 means no wrapping.
+	Width int
+	// MaxWidth caps Width when set, even if Width is larger or unset.
+	MaxWidth int
+
+	// PrefixStyle styles the leading tag rendered by Badge, e.g. "INFO".
+	PrefixStyle Style
+	// MessageStyle styles the message body rendered by Message.
+	MessageStyle Style
+	// Icon is an optional unicode glyph (e.g. '✔'/'✖'/'ℹ') shown before the badge.
+	Icon rune
 }
 
 // NewTheme instance
 func NewTheme(name string, style Style) *Theme {
-	return &Theme{name, style}
+	return &Theme{Name: name, Style: style}
 }
 
 // Save to themes map
@@ -122,11 +144,16 @@ func (t *Theme) Prompt(format string, a ...interface{}) {
 	t.Println(title, fmt.Sprintf(format, a...))
 }
 
-// Block like Prompt, but will wrap a empty line
+// Block like Prompt, but renders the message through Theme.Render so a
+// theme's border/padding/alignment are applied to boxed notices. Both the
+// write destination and whether ANSI is emitted at all come from
+// DefaultRenderer, so it stays redirectable along with every other print
+// path in the package.
 func (t *Theme) Block(format string, a ...interface{}) {
+	r := DefaultRenderer()
 	title := strings.ToUpper(t.Name) + ":\n"
 
-	t.Println(title, fmt.Sprintf(format, a...))
+	fmt.Fprint(r.Writer(), t.RenderWith(r, title+fmt.Sprintf(format, a...)))
 }
 
 /*************************************************************
@@ -141,31 +168,31 @@ func (t *Theme) Block(format string, a ...interface{}) {
 // 	color.Error.Println("message")
 var (
 	// Info color style
-	Info = &Theme{"info", Style{OpReset, FgGreen}}
+	Info = &Theme{Name: "info", Style: Style{OpReset, FgGreen}}
 	// Note color style
-	Note = &Theme{"note", Style{OpBold, FgLightCyan}}
+	Note = &Theme{Name: "note", Style: Style{OpBold, FgLightCyan}}
 	// Warn color style
-	Warn = &Theme{"warning", Style{OpBold, FgYellow}}
+	Warn = &Theme{Name: "warning", Style: Style{OpBold, FgYellow}}
 	// Light color style
-	Light = &Theme{"light", Style{FgLightWhite, BgBlack}}
+	Light = &Theme{Name: "light", Style: Style{FgLightWhite, BgBlack}}
 	// Error color style
-	Error = &Theme{"error", Style{FgLightWhite, BgRed}}
+	Error = &Theme{Name: "error", Style: Style{FgLightWhite, BgRed}}
 	// Danger color style
-	Danger = &Theme{"danger", Style{OpBold, FgRed}}
+	Danger = &Theme{Name: "danger", Style: Style{OpBold, FgRed}}
 	// Debug color style
-	Debug = &Theme{"debug", Style{OpReset, FgCyan}}
+	Debug = &Theme{Name: "debug", Style: Style{OpReset, FgCyan}}
 	// Notice color style
-	Notice = &Theme{"notice", Style{OpBold, FgCyan}}
+	Notice = &Theme{Name: "notice", Style: Style{OpBold, FgCyan}}
 	// Comment color style
-	Comment = &Theme{"comment", Style{OpReset, FgLightYellow}}
+	Comment = &Theme{Name: "comment", Style: Style{OpReset, FgLightYellow}}
 	// Success color style
-	Success = &Theme{"success", Style{OpBold, FgGreen}}
+	Success = &Theme{Name: "success", Style: Style{OpBold, FgGreen}}
 	// Primary color style
-	Primary = &Theme{"primary", Style{OpReset, FgBlue}}
+	Primary = &Theme{Name: "primary", Style: Style{OpReset, FgBlue}}
 	// Question color style
-	Question = &Theme{"question", Style{OpReset, FgMagenta}}
+	Question = &Theme{Name: "question", Style: Style{OpReset, FgMagenta}}
 	// Secondary color style
-	Secondary = &Theme{"secondary", Style{FgDarkGray}}
+	Secondary = &Theme{Name: "secondary", Style: Style{FgDarkGray}}
 )
 
 // Themes internal defined themes.
@@ -254,33 +281,33 @@ func GetStyle(name string) Style {
  * quick use style print message
  *************************************************************/
 
-// Infof print message with Info style
+// Infof print message with Info style, via the DefaultRenderer
 func Infof(format string, a ...interface{}) {
-	Info.Printf(format, a...)
+	DefaultRenderer().Printf(Info.Style, format, a...)
 }
 
-// Infoln print message with Info style
+// Infoln print message with Info style, via the DefaultRenderer
 func Infoln(a ...interface{}) {
-	Info.Println(a...)
+	DefaultRenderer().Println(Info.Style, a...)
 }
 
-// Errorf print message with Error style
+// Errorf print message with Error style, via the DefaultRenderer
 func Errorf(format string, a ...interface{}) {
-	Error.Printf(format, a...)
+	DefaultRenderer().Printf(Error.Style, format, a...)
 }
 
-// Errorln print message with Error style
+// Errorln print message with Error style, via the DefaultRenderer
 func Errorln(a ...interface{}) {
-	Error.Println(a...)
+	DefaultRenderer().Println(Error.Style, a...)
 }
 
-// Warnf print message with Warn style
+// Warnf print message with Warn style, via the DefaultRenderer
 func Warnf(format string, a ...interface{}) {
-	Warn.Printf(format, a...)
+	DefaultRenderer().Printf(Warn.Style, format, a...)
 }
 
-// Warnln print message with Warn style
+// Warnln print message with Warn style, via the DefaultRenderer
 func Warnln(a ...interface{}) {
-	Warn.Println(a...)
+	DefaultRenderer().Println(Warn.Style, a...)
 }
 
@@ -0,0 +1,171 @@
+package color
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+/*************************************************************
+ * text/template and html/template helpers
+ *************************************************************/
+
+// optionTags maps an option tag name (as used in FuncMap's "style" helper)
+// to the Color it represents. Kept separate from the fg/bg name maps
+// since options apply regardless of ground.
+var optionTags = map[string]Color{
+	"reset":      OpReset,
+	"bold":       OpBold,
+	"fuzzy":      OpFuzzy,
+	"italic":     OpItalic,
+	"underscore": OpUnderscore,
+	"blink":      OpBlink,
+	"fastblink":  OpFastBlink,
+	"reverse":    OpReverse,
+	"concealed":  OpConcealed,
+}
+
+// styleFromTags parses a comma-separated tag list like "red,bold" or
+// "fg=green,bg=black" into a Style, by looking up each tag in the fg/bg
+// color name maps and the option name map. Unknown tags are ignored.
+func styleFromTags(tags string) Style {
+	s := New()
+	for _, tag := range strings.Split(tags, ",") {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+
+		if strings.HasPrefix(tag, "fg=") {
+			if c, ok := FgColors[tag[3:]]; ok {
+				s = append(s, c)
+			}
+			continue
+		}
+		if strings.HasPrefix(tag, "bg=") {
+			if c, ok := BgColors[tag[3:]]; ok {
+				s = append(s, c)
+			}
+			continue
+		}
+
+		if c, ok := optionTags[tag]; ok {
+			s = append(s, c)
+			continue
+		}
+		if c, ok := FgColors[tag]; ok {
+			s = append(s, c)
+			continue
+		}
+		if c, ok := BgColors[tag]; ok {
+			s = append(s, c)
+			continue
+		}
+	}
+	return s
+}
+
+// resolveName looks up name as a style/theme name, honoring aliases
+// (err, warn, suc) before falling back to a tag list parsed by styleFromTags.
+func resolveName(name string) Style {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if theme := GetTheme(name); theme != nil {
+		return theme.Style
+	}
+	if s, ok := Styles[name]; ok {
+		return s
+	}
+	if realName, ok := styleAliases[name]; ok {
+		return Styles[realName]
+	}
+
+	return styleFromTags(name)
+}
+
+// FuncMap returns a text/template.FuncMap exposing color helpers:
+//
+//	{{style "red,bold" "hello"}}  - render text with an ad-hoc tag list
+//	{{theme "error" "boom"}}      - render text with a named theme
+//	{{fg "green" .Name}}          - render text with a foreground color
+//	{{bg "black" .Val}}           - render text with a background color
+//	{{stripcolor .}}              - remove ANSI sequences from a value
+//
+// Usage:
+//	tpl := texttemplate.New("demo").Funcs(color.FuncMap())
+func FuncMap() texttemplate.FuncMap {
+	return texttemplate.FuncMap{
+		"style":      tplStyle,
+		"theme":      tplTheme,
+		"fg":         tplFg,
+		"bg":         tplBg,
+		"stripcolor": ClearCode,
+	}
+}
+
+// HTMLFuncMap returns the html/template equivalent of FuncMap, rendering
+// results as template.HTML so the ANSI escape codes aren't re-escaped.
+// Unlike FuncMap, the interpolated value itself is still HTML-escaped
+// before being wrapped in ANSI codes, so untrusted data (e.g. {{fg
+// "green" .Name}}) can't smuggle markup through the "safe" ANSI wrapper.
+func HTMLFuncMap() template.FuncMap {
+	return template.FuncMap{
+		"style": func(tags, text string) template.HTML {
+			return template.HTML(styleFromTags(tags).Render(template.HTMLEscapeString(text)))
+		},
+		"theme": func(name, text string) template.HTML {
+			return template.HTML(resolveName(name).Render(template.HTMLEscapeString(text)))
+		},
+		"fg": func(name string, v interface{}) template.HTML {
+			return template.HTML(tplFgEscaped(name, template.HTMLEscapeString(toString(v))))
+		},
+		"bg": func(name string, v interface{}) template.HTML {
+			return template.HTML(tplBgEscaped(name, template.HTMLEscapeString(toString(v))))
+		},
+		"stripcolor": func(v interface{}) template.HTML {
+			return template.HTML(template.HTMLEscapeString(ClearCode(toString(v))))
+		},
+	}
+}
+
+func tplStyle(tags, text string) string {
+	return styleFromTags(tags).Render(text)
+}
+
+func tplTheme(name, text string) string {
+	return resolveName(name).Render(text)
+}
+
+func tplFg(name string, v interface{}) string {
+	return tplFgEscaped(name, toString(v))
+}
+
+func tplBg(name string, v interface{}) string {
+	return tplBgEscaped(name, toString(v))
+}
+
+// tplFgEscaped renders text (already HTML-escaped by the caller when used
+// from HTMLFuncMap) with the named foreground color.
+func tplFgEscaped(name, text string) string {
+	if c, ok := FgColors[strings.ToLower(name)]; ok {
+		return New(c).Render(text)
+	}
+	return text
+}
+
+// tplBgEscaped renders text (already HTML-escaped by the caller when used
+// from HTMLFuncMap) with the named background color.
+func tplBgEscaped(name, text string) string {
+	if c, ok := BgColors[strings.ToLower(name)]; ok {
+		return New(c).Render(text)
+	}
+	return text
+}
+
+func toString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
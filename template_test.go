@@ -0,0 +1,29 @@
+package color
+
+import (
+	"bytes"
+	"html/template"
+	"strings"
+	"testing"
+)
+
+func TestHTMLFuncMap_escapesInterpolatedValues(t *testing.T) {
+	const payload = `<script>alert(1)</script>`
+
+	tpl := template.Must(template.New("t").Funcs(HTMLFuncMap()).Parse(
+		`{{fg "green" .}}|{{bg "black" .}}|{{style "red,bold" .}}|{{theme "error" .}}|{{stripcolor .}}`,
+	))
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, payload); err != nil {
+		t.Fatalf("template execution failed: %v", err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, "<script>") {
+		t.Fatalf("HTMLFuncMap helpers must HTML-escape interpolated values, got unescaped markup: %q", out)
+	}
+	if !strings.Contains(out, "&lt;script&gt;") {
+		t.Fatalf("expected the escaped payload to appear in the output, got %q", out)
+	}
+}
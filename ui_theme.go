@@ -0,0 +1,118 @@
+package color
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*************************************************************
+ * Theme: prefix/message split styling and UI-component themes
+ *************************************************************/
+
+// Badge renders text as a leading tag (e.g. "INFO") using t.PrefixStyle,
+// padded with a space on each side so it reads like a colored badge. It
+// styles only when DefaultRenderer has color enabled; use BadgeWith to gate
+// on another Renderer.
+//
+// Usage:
+//	color.Themes["info"].Badge("INFO") // => " INFO " styled with inverse fg/bg
+func (t *Theme) Badge(text string) string {
+	return t.BadgeWith(DefaultRenderer(), text)
+}
+
+// BadgeWith is Badge, but renders plain "<space>text<space>" (no ANSI) when
+// r reports color is not enabled for its bound writer.
+func (t *Theme) BadgeWith(r *Renderer, text string) string {
+	if r != nil && !r.isEnabled() {
+		return " " + text + " "
+	}
+	return t.PrefixStyle.Render(" " + text + " ")
+}
+
+// Message renders "[icon] [badge] message" in one call and prints it,
+// using t.Icon, Theme.Badge(prefix) and t.MessageStyle for the body.
+// This is the building block higher-level widgets (progress bars,
+// tables, banners) use so they automatically pick up a user-configured
+// palette via AddTheme/SetTheme. It writes through DefaultRenderer, so
+// both the destination and whether ANSI is emitted follow it.
+func (t *Theme) Message(prefix, format string, a ...interface{}) {
+	t.MessageWith(DefaultRenderer(), prefix, format, a...)
+}
+
+// MessageWith is Message, but writes to r's writer and skips ANSI styling
+// on the badge/icon/message body when r reports color is not enabled.
+func (t *Theme) MessageWith(r *Renderer, prefix, format string, a ...interface{}) {
+	enabled := r == nil || r.isEnabled()
+
+	var b strings.Builder
+	if t.Icon != 0 {
+		b.WriteRune(t.Icon)
+		b.WriteByte(' ')
+	}
+	b.WriteString(t.BadgeWith(r, prefix))
+	b.WriteByte(' ')
+	if enabled {
+		b.WriteString(t.MessageStyle.Sprintf(format, a...))
+	} else {
+		b.WriteString(fmt.Sprintf(format, a...))
+	}
+
+	w := Output
+	if r != nil {
+		w = r.Writer()
+	}
+	fmt.Fprintln(w, b.String())
+}
+
+// SetTheme registers or overrides a theme's prefix/message styles and
+// icon, creating the theme if name isn't already registered. The message
+// style also becomes the theme's base Style, so Styles[name] and plain
+// Print/Println continue to work.
+//
+// Usage:
+//	color.SetTheme("info", color.New(color.FgBlack, color.BgGreen, color.OpBold), color.New(color.FgGreen), '✔' )
+func SetTheme(name string, prefix, msg Style, icon rune) {
+	t, ok := Themes[name]
+	if !ok {
+		t = &Theme{Name: name}
+	}
+
+	t.Style = msg
+	t.PrefixStyle = prefix
+	t.MessageStyle = msg
+	t.Icon = icon
+
+	Themes[name] = t
+	Styles[name] = msg
+}
+
+func init() {
+	// fold prefix/message defaults into the existing bootstrap-style themes,
+	// keeping the message style identical to each theme's original Style so
+	// plain Print/Println/etc. keep behaving exactly as before.
+	SetTheme(Info.Name, New(FgBlack, BgGreen, OpBold), New(OpReset, FgGreen), 'ℹ')
+	SetTheme(Note.Name, New(FgBlack, BgCyan, OpBold), New(OpBold, FgLightCyan), 0)
+	SetTheme(Warn.Name, New(FgBlack, BgYellow, OpBold), New(OpBold, FgYellow), '⚠')
+	SetTheme(Light.Name, New(FgBlack, BgWhite, OpBold), New(FgLightWhite, BgBlack), 0)
+	SetTheme(Error.Name, New(FgWhite, BgRed, OpBold), New(FgLightWhite, BgRed), '✖')
+	SetTheme(Danger.Name, New(FgWhite, BgRed, OpBold), New(OpBold, FgRed), '✖')
+	SetTheme(Debug.Name, New(FgBlack, BgCyan, OpBold), New(OpReset, FgCyan), 0)
+	SetTheme(Notice.Name, New(FgBlack, BgCyan, OpBold), New(OpBold, FgCyan), 0)
+	SetTheme(Comment.Name, New(FgBlack, BgYellow, OpBold), New(OpReset, FgLightYellow), 0)
+	SetTheme(Success.Name, New(FgBlack, BgGreen, OpBold), New(OpBold, FgGreen), '✔')
+	SetTheme(Question.Name, New(FgWhite, BgMagenta, OpBold), New(OpReset, FgMagenta), 0)
+
+	SetTheme(Primary.Name, New(FgLightWhite, BgBlue, OpBold), New(OpReset, FgBlue), 0)
+	SetTheme(Secondary.Name, New(FgLightWhite, BgDarkGray, OpBold), New(FgDarkGray), 0)
+
+	// UI-component themes, matching the surface a pterm-style widget set needs
+	SetTheme("highlight", New(OpReverse, FgYellow), New(FgYellow, OpBold), 0)
+	SetTheme("header", New(FgLightWhite, BgBlue, OpBold), New(FgBlue, OpBold), 0)
+	SetTheme("spinner", New(FgCyan, OpBold), New(FgCyan), 0)
+	SetTheme("progressbar-bar", New(FgGreen, OpBold), New(FgGreen), 0)
+	SetTheme("progressbar-title", New(FgLightWhite, OpBold), New(FgWhite), 0)
+	SetTheme("table-header", New(FgLightWhite, BgDarkGray, OpBold), New(FgLightWhite, OpBold), 0)
+	SetTheme("table-separator", New(FgDarkGray), New(FgDarkGray), 0)
+	SetTheme("tree", New(FgCyan), New(FgWhite), 0)
+	SetTheme("bullet", New(FgMagenta, OpBold), New(FgWhite), 0)
+}
@@ -0,0 +1,43 @@
+package color
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTheme_BadgeMessageWith_disabledSkipsANSI(t *testing.T) {
+	buf := new(bytes.Buffer)
+	r := NewRendererWithMode(buf, ModeNever)
+
+	theme := &Theme{
+		Name: "test", Style: Style{FgLightWhite},
+		PrefixStyle: Style{FgBlack, BgGreen}, MessageStyle: Style{FgGreen},
+	}
+
+	if badge := theme.BadgeWith(r, "INFO"); strings.Contains(badge, "\x1b[") {
+		t.Fatalf("BadgeWith with ModeNever renderer must not emit ANSI, got %q", badge)
+	}
+
+	theme.MessageWith(r, "INFO", "boom %d", 1)
+	if out := buf.String(); strings.Contains(out, "\x1b[") {
+		t.Fatalf("MessageWith with ModeNever renderer must not emit ANSI, got %q", out)
+	} else if !strings.Contains(out, "boom 1") {
+		t.Fatalf("expected message body in output, got %q", out)
+	}
+}
+
+func TestTheme_MessageWith_writesToRendererWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	r := NewRendererWithMode(buf, ModeAlways)
+
+	theme := &Theme{
+		Name: "test", Style: Style{FgGreen},
+		PrefixStyle: Style{FgBlack, BgGreen}, MessageStyle: Style{FgGreen}, Icon: '✔',
+	}
+	theme.MessageWith(r, "OK", "done")
+
+	if buf.Len() == 0 {
+		t.Fatalf("expected MessageWith to write to the bound renderer's writer, buffer is empty")
+	}
+}